@@ -0,0 +1,63 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"fmt"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	admissionregistrationv1beta1 "k8s.io/api/admissionregistration/v1beta1"
+	"k8s.io/client-go/discovery"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// defaultAdmissionReviewVersions is used by a ClusterAdmissionPolicy or
+// AdmissionPolicy that does not set Spec.AdmissionReviewVersions. v1 is
+// served by every supported Kubernetes version, so it is the safe default.
+var defaultAdmissionReviewVersions = []string{"v1"}
+
+// discoverSupportedAdmissionReviewVersions probes the API server's discovery
+// document once at startup for the admissionregistration.k8s.io versions it
+// serves, so the controller registers the v1beta1 webhook configuration
+// watches only where v1beta1 is served (the v1 watches are always
+// registered) and rejects policies that ask for a version that isn't served,
+// instead of generating webhook configurations the API server would refuse.
+func discoverSupportedAdmissionReviewVersions(mgr ctrl.Manager) (map[string]bool, error) {
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(mgr.GetConfig())
+	if err != nil {
+		return nil, fmt.Errorf("cannot build discovery client: %w", err)
+	}
+
+	supported := map[string]bool{
+		"v1":      isGroupVersionServed(discoveryClient, admissionregistrationv1.SchemeGroupVersion.String()),
+		"v1beta1": isGroupVersionServed(discoveryClient, admissionregistrationv1beta1.SchemeGroupVersion.String()),
+	}
+
+	return supported, nil
+}
+
+// isGroupVersionServed reports whether the cluster's discovery document
+// actually serves groupVersion. Edge clusters may serve only v1beta1 or only
+// v1, so discovery errors for a specific group/version are treated as "not
+// supported" instead of failing startup.
+func isGroupVersionServed(discoveryClient discovery.DiscoveryInterface, groupVersion string) bool {
+	resources, err := discoveryClient.ServerResourcesForGroupVersion(groupVersion)
+	if err != nil {
+		return false
+	}
+	return len(resources.APIResources) > 0
+}
@@ -24,14 +24,19 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/kubewarden/kubewarden-controller/internal/pkg/admission"
 	"github.com/kubewarden/kubewarden-controller/internal/pkg/constants"
+	"github.com/kubewarden/kubewarden-controller/internal/pkg/events"
 	"github.com/kubewarden/kubewarden-controller/internal/pkg/naming"
 	policiesv1 "github.com/kubewarden/kubewarden-controller/pkg/apis/policies/v1"
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	admissionregistrationv1beta1 "k8s.io/api/admissionregistration/v1beta1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
@@ -55,9 +60,23 @@ import (
 // ClusterAdmissionPolicyReconciler reconciles a ClusterAdmissionPolicy object
 type ClusterAdmissionPolicyReconciler struct {
 	client.Client
-	Log        logr.Logger
-	Scheme     *runtime.Scheme
-	Reconciler admission.Reconciler
+	Log           logr.Logger
+	Scheme        *runtime.Scheme
+	Reconciler    admission.Reconciler
+	EventRecorder record.EventRecorder
+
+	// UninstallMode is flipped to true once the background watcher started
+	// in SetupWithManager detects that the controller itself is being
+	// uninstalled. While true, Reconcile force-removes the finalizer from
+	// policies pending deletion instead of waiting on the normal cleanup.
+	UninstallMode bool
+	// Cancel stops the manager once UninstallMode is detected.
+	Cancel context.CancelFunc
+
+	// supportedAdmissionReviewVersions caches the result of the one-time
+	// admissionregistration.k8s.io discovery probe run in SetupWithManager,
+	// keyed by version (e.g. "v1", "v1beta1").
+	supportedAdmissionReviewVersions map[string]bool
 }
 
 // Reconcile reconciles admission policies
@@ -70,12 +89,112 @@ func (r *ClusterAdmissionPolicyReconciler) Reconcile(ctx context.Context, req ct
 		return ctrl.Result{}, fmt.Errorf("cannot retrieve admission policy: %w", err)
 	}
 
-	return startReconciling(ctx, r.Reconciler.Client, r.Reconciler, &clusterAdmissionPolicy)
+	if r.UninstallMode && clusterAdmissionPolicy.DeletionTimestamp != nil {
+		controllerutil.RemoveFinalizer(&clusterAdmissionPolicy, constants.KubewardenFinalizer)
+		if err := r.Update(ctx, &clusterAdmissionPolicy); err != nil && !apierrors.IsConflict(err) {
+			return ctrl.Result{}, fmt.Errorf("cannot force-remove finalizer while uninstalling: %w", err)
+		}
+		return ctrl.Result{}, nil
+	}
+
+	// wasAccepted/wasObserved let reject/accept returns below fire an event
+	// exactly once per actual state change instead of on every successful
+	// reconcile, which would otherwise spam the Events API on steady-state
+	// reconciles.
+	wasAccepted := clusterAdmissionPolicy.Status.Accepted
+	wasObserved := clusterAdmissionPolicy.Status.ObservedGeneration == clusterAdmissionPolicy.Generation
+	clusterAdmissionPolicy.Status.ObservedGeneration = clusterAdmissionPolicy.Generation
+
+	reject := func(reason, message string) (ctrl.Result, error) {
+		clusterAdmissionPolicy.Status.Accepted = false
+		if wasAccepted || !wasObserved {
+			events.SendPolicyEvent(r.EventRecorder, &clusterAdmissionPolicy, reason, message, events.SeverityWarning)
+		}
+		if err := r.Status().Update(ctx, &clusterAdmissionPolicy); err != nil && !apierrors.IsConflict(err) {
+			r.Log.Error(err, "cannot update cluster admission policy status", "policy", clusterAdmissionPolicy.Name)
+		}
+		return ctrl.Result{}, errors.New(message)
+	}
+
+	if err := r.validateAdmissionReviewVersions(&clusterAdmissionPolicy); err != nil {
+		return reject("PolicyRejected", err.Error())
+	}
+
+	if err := resolveTemplateRef(ctx, r.Reconciler.Client, &clusterAdmissionPolicy.Spec); err != nil {
+		return reject("PolicyTemplateResolutionFailed", err.Error())
+	}
+
+	result, err := startReconciling(ctx, r.Reconciler.Client, r.Reconciler, &clusterAdmissionPolicy)
+	if err != nil {
+		return reject("PolicyRejected", err.Error())
+	}
+
+	negotiatedVersion := negotiatedAdmissionReviewVersion(clusterAdmissionPolicy.Spec.AdmissionReviewVersions, r.supportedAdmissionReviewVersions)
+	webhookConfigurationChanged, err := ensureAdmissionReviewVersionsWebhookConfiguration(ctx, r.Reconciler.Client, clusterAdmissionPolicy.Name, negotiatedVersion)
+	if err != nil {
+		return reject("PolicyRejected", err.Error())
+	}
+	if webhookConfigurationChanged {
+		events.SendPolicyEvent(r.EventRecorder, &clusterAdmissionPolicy, "WebhookConfigurationUpdated", fmt.Sprintf("webhook configuration for policy %s was created or updated", clusterAdmissionPolicy.Name), events.SeverityInfo)
+	}
+
+	clusterAdmissionPolicy.Status.Accepted = true
+	if !wasAccepted || !wasObserved {
+		events.SendPolicyEvent(r.EventRecorder, &clusterAdmissionPolicy, "PolicyAccepted", "policy accepted by the policy server", events.SeverityInfo)
+	}
+	if err := r.Status().Update(ctx, &clusterAdmissionPolicy); err != nil && !apierrors.IsConflict(err) {
+		return ctrl.Result{}, fmt.Errorf("update cluster admission policy status error: %w", err)
+	}
+
+	return result, nil
+}
+
+// validateAdmissionReviewVersions checks that every version the policy
+// requests in Spec.AdmissionReviewVersions (defaulting to
+// defaultAdmissionReviewVersions) is actually served by the cluster's
+// admissionregistration.k8s.io API, per the discovery probe cached in
+// r.supportedAdmissionReviewVersions, instead of letting the API server
+// reject the generated webhook configuration at apply time.
+func (r *ClusterAdmissionPolicyReconciler) validateAdmissionReviewVersions(policy *policiesv1.ClusterAdmissionPolicy) error {
+	versions := policy.Spec.AdmissionReviewVersions
+	if len(versions) == 0 {
+		versions = defaultAdmissionReviewVersions
+	}
+	for _, version := range versions {
+		if !r.supportedAdmissionReviewVersions[version] {
+			return fmt.Errorf("admission review version %q requested by policy %s is not served by this cluster", version, policy.Name)
+		}
+	}
+	return nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *ClusterAdmissionPolicyReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	err := ctrl.NewControllerManagedBy(mgr).
+	r.EventRecorder = mgr.GetEventRecorderFor("kubewarden-controller")
+
+	if r.Cancel != nil {
+		if err := startUninstallWatcher(mgr, r.Cancel, r.Reconciler.DeploymentsNamespace, constants.ControllerDeploymentName, &r.UninstallMode); err != nil {
+			return fmt.Errorf("failed starting uninstall watcher: %w", err)
+		}
+	}
+
+	err := mgr.GetFieldIndexer().IndexField(context.Background(), &policiesv1.ClusterAdmissionPolicy{}, constants.PolicyTemplateIndexKey, func(object client.Object) []string {
+		policy, ok := object.(*policiesv1.ClusterAdmissionPolicy)
+		if !ok || policy.Spec.TemplateRef == nil {
+			return []string{}
+		}
+		return []string{policy.Spec.TemplateRef.Name}
+	})
+	if err != nil {
+		return fmt.Errorf("failed enrolling controller with manager: %w", err)
+	}
+
+	r.supportedAdmissionReviewVersions, err = discoverSupportedAdmissionReviewVersions(mgr)
+	if err != nil {
+		return fmt.Errorf("failed discovering supported admission review versions: %w", err)
+	}
+
+	controllerBuilder := ctrl.NewControllerManagedBy(mgr).
 		For(&policiesv1.ClusterAdmissionPolicy{}).
 		Watches(
 			&corev1.Pod{},
@@ -89,22 +208,45 @@ func (r *ClusterAdmissionPolicyReconciler) SetupWithManager(mgr ctrl.Manager) er
 			&policiesv1.PolicyServer{},
 			handler.EnqueueRequestsFromMapFunc(r.findClusterAdmissionPoliciesForPolicyServer),
 		).
+		// Only metadata is needed to resolve the owning policy, and a
+		// metav1.PartialObjectMetadata map func works the same whether the
+		// watched object is a v1 or a v1beta1 webhook configuration.
 		Watches(
 			&admissionregistrationv1.ValidatingWebhookConfiguration{},
 			handler.EnqueueRequestsFromMapFunc(r.findClusterAdmissionPolicyForWebhookConfiguration),
+			builder.OnlyMetadata,
 		).
 		Watches(
 			&admissionregistrationv1.MutatingWebhookConfiguration{},
 			handler.EnqueueRequestsFromMapFunc(r.findClusterAdmissionPolicyForWebhookConfiguration),
+			builder.OnlyMetadata,
 		).
-		Complete(r)
-	if err != nil {
+		Watches(
+			&policiesv1.PolicyTemplate{},
+			handler.EnqueueRequestsFromMapFunc(r.findClusterAdmissionPoliciesForPolicyTemplate),
+		)
+
+	if r.supportedAdmissionReviewVersions["v1beta1"] {
+		controllerBuilder = controllerBuilder.
+			Watches(
+				&admissionregistrationv1beta1.ValidatingWebhookConfiguration{},
+				handler.EnqueueRequestsFromMapFunc(r.findClusterAdmissionPolicyForWebhookConfiguration),
+				builder.OnlyMetadata,
+			).
+			Watches(
+				&admissionregistrationv1beta1.MutatingWebhookConfiguration{},
+				handler.EnqueueRequestsFromMapFunc(r.findClusterAdmissionPolicyForWebhookConfiguration),
+				builder.OnlyMetadata,
+			)
+	}
+
+	if err := controllerBuilder.Complete(r); err != nil {
 		return errors.Join(errors.New("failed enrolling controller with manager"), err)
 	}
 	return nil
 }
 
-func (r *ClusterAdmissionPolicyReconciler) findClusterAdmissionPoliciesForConfigMap(object client.Object) []reconcile.Request {
+func (r *ClusterAdmissionPolicyReconciler) findClusterAdmissionPoliciesForConfigMap(ctx context.Context, object client.Object) []reconcile.Request {
 	configMap, ok := object.(*corev1.ConfigMap)
 	if !ok {
 		return []reconcile.Request{}
@@ -113,7 +255,17 @@ func (r *ClusterAdmissionPolicyReconciler) findClusterAdmissionPoliciesForConfig
 	if err != nil {
 		return []reconcile.Request{}
 	}
-	return policyMap.ToClusterAdmissionPolicyReconcileRequests()
+
+	requests := policyMap.ToClusterAdmissionPolicyReconcileRequests()
+
+	relatedObject := policiesv1.NewRelatedObject(configMap, corev1.SchemeGroupVersion.String(), "ConfigMap", true, "")
+	for _, request := range requests {
+		if err := r.upsertClusterAdmissionPolicyRelatedObject(ctx, request.Name, relatedObject); err != nil {
+			r.Log.Error(err, "cannot persist related object for policy server config map", "policy", request.Name, "config-map", configMap.Name)
+		}
+	}
+
+	return requests
 }
 
 func (r *ClusterAdmissionPolicyReconciler) findClusterAdmissionPoliciesForPod(ctx context.Context, object client.Object) []reconcile.Request {
@@ -134,7 +286,7 @@ func (r *ClusterAdmissionPolicyReconciler) findClusterAdmissionPoliciesForPod(ct
 	if err != nil {
 		return []reconcile.Request{}
 	}
-	return r.findClusterAdmissionPoliciesForConfigMap(&configMap)
+	return r.findClusterAdmissionPoliciesForConfigMap(ctx, &configMap)
 }
 
 func (r *ClusterAdmissionPolicyReconciler) findClusterAdmissionPoliciesForPolicyServer(ctx context.Context, object client.Object) []reconcile.Request {
@@ -151,10 +303,54 @@ func (r *ClusterAdmissionPolicyReconciler) findClusterAdmissionPoliciesForPolicy
 	if err != nil {
 		return []reconcile.Request{}
 	}
-	return r.findClusterAdmissionPoliciesForConfigMap(&configMap)
+	return r.findClusterAdmissionPoliciesForConfigMap(ctx, &configMap)
+}
+
+// upsertClusterAdmissionPolicyRelatedObject persists relatedObject onto
+// policyName's Status.RelatedObjects, replacing any existing entry for the
+// same Kind/Name/Namespace so repeated reconciles don't accumulate
+// duplicates, so a single `kubectl get clusteradmissionpolicy -o yaml` shows
+// the full topology instead of only a log line.
+func (r *ClusterAdmissionPolicyReconciler) upsertClusterAdmissionPolicyRelatedObject(ctx context.Context, policyName string, relatedObject policiesv1.RelatedObject) error {
+	var policy policiesv1.ClusterAdmissionPolicy
+	if err := r.Get(ctx, client.ObjectKey{Name: policyName}, &policy); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("cannot retrieve cluster admission policy %s: %w", policyName, err)
+	}
+
+	policy.Status.RelatedObjects = upsertRelatedObject(policy.Status.RelatedObjects, relatedObject)
+
+	if err := r.Status().Update(ctx, &policy); err != nil {
+		if apierrors.IsConflict(err) {
+			return nil
+		}
+		return fmt.Errorf("cannot update status of cluster admission policy %s: %w", policyName, err)
+	}
+	return nil
+}
+
+// upsertRelatedObject returns relatedObjects with newObject replacing any
+// existing entry that shares its Kind/Name/Namespace, or appended if none
+// does.
+func upsertRelatedObject(relatedObjects []policiesv1.RelatedObject, newObject policiesv1.RelatedObject) []policiesv1.RelatedObject {
+	for i, existing := range relatedObjects {
+		if existing.Kind == newObject.Kind && existing.Metadata == newObject.Metadata {
+			relatedObjects[i] = newObject
+			return relatedObjects
+		}
+	}
+	return append(relatedObjects, newObject)
 }
 
-func (r *ClusterAdmissionPolicyReconciler) findClusterAdmissionPolicyForWebhookConfiguration(_ context.Context, webhookConfiguration client.Object) []reconcile.Request {
+// findClusterAdmissionPolicyForWebhookConfiguration enqueues the
+// ClusterAdmissionPolicy referenced by a changed webhook configuration.
+// Since the watches registering this map func use builder.OnlyMetadata,
+// webhookConfiguration arrives as a metav1.PartialObjectMetadata regardless
+// of whether it was a v1 or v1beta1 Mutating/ValidatingWebhookConfiguration,
+// so both versions funnel into the same reconcile queue.
+func (r *ClusterAdmissionPolicyReconciler) findClusterAdmissionPolicyForWebhookConfiguration(ctx context.Context, webhookConfiguration client.Object) []reconcile.Request {
 	if _, found := webhookConfiguration.GetLabels()["kubewarden"]; !found {
 		return []reconcile.Request{}
 	}
@@ -176,6 +372,18 @@ func (r *ClusterAdmissionPolicyReconciler) findClusterAdmissionPolicyForWebhookC
 		return []reconcile.Request{}
 	}
 
+	// Persist the RelatedObject entry for this webhook configuration onto the
+	// owning policy's status right away, instead of only refreshing it the
+	// next time that policy itself reconciles.
+	apiVersion := webhookConfiguration.GetObjectKind().GroupVersionKind().GroupVersion().String()
+	if apiVersion == "" {
+		apiVersion = admissionregistrationv1.SchemeGroupVersion.String()
+	}
+	relatedObject := policiesv1.NewRelatedObject(webhookConfiguration, apiVersion, webhookConfigurationKind(webhookConfiguration), true, "")
+	if err := r.upsertClusterAdmissionPolicyRelatedObject(ctx, policyName, relatedObject); err != nil {
+		r.Log.Error(err, "cannot persist related object for webhook configuration", "policy", policyName, "webhook-configuration", webhookConfiguration.GetName())
+	}
+
 	return []reconcile.Request{
 		{
 			NamespacedName: client.ObjectKey{
@@ -184,3 +392,23 @@ func (r *ClusterAdmissionPolicyReconciler) findClusterAdmissionPolicyForWebhookC
 		},
 	}
 }
+
+// webhookConfigurationKind returns the Kind of a
+// Mutating/ValidatingWebhookConfiguration object, for both the v1 and
+// v1beta1 admissionregistration APIs. metav1.PartialObjectMetadata objects
+// (delivered to watches registered with builder.OnlyMetadata) carry their
+// GroupVersionKind directly; the type switch is a fallback for callers that
+// still pass a concrete, typed object.
+func webhookConfigurationKind(webhookConfiguration client.Object) string {
+	if kind := webhookConfiguration.GetObjectKind().GroupVersionKind().Kind; kind != "" {
+		return kind
+	}
+	switch webhookConfiguration.(type) {
+	case *admissionregistrationv1.MutatingWebhookConfiguration, *admissionregistrationv1beta1.MutatingWebhookConfiguration:
+		return "MutatingWebhookConfiguration"
+	case *admissionregistrationv1.ValidatingWebhookConfiguration, *admissionregistrationv1beta1.ValidatingWebhookConfiguration:
+		return "ValidatingWebhookConfiguration"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+
+	policiesv1 "github.com/kubewarden/kubewarden-controller/pkg/apis/policies/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestUpsertRelatedObjectReplacesSameKindAndMetadata(t *testing.T) {
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "policy-a-configmap"}}
+	relatedObjects := []policiesv1.RelatedObject{
+		policiesv1.NewRelatedObject(configMap, "v1", "ConfigMap", true, ""),
+	}
+
+	updated := upsertRelatedObject(relatedObjects, policiesv1.NewRelatedObject(configMap, "v1", "ConfigMap", false, "out of sync"))
+
+	if len(updated) != 1 {
+		t.Fatalf("expected the existing entry to be replaced, not appended; got %d entries", len(updated))
+	}
+	if updated[0].Compliant {
+		t.Errorf("expected the replaced entry to carry the new Compliant value")
+	}
+}
+
+func TestUpsertRelatedObjectAppendsDifferentKind(t *testing.T) {
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "policy-a-configmap"}}
+	relatedObjects := []policiesv1.RelatedObject{
+		policiesv1.NewRelatedObject(configMap, "v1", "ConfigMap", true, ""),
+	}
+
+	updated := upsertRelatedObject(relatedObjects, policiesv1.NewRelatedObject(configMap, "admissionregistration.k8s.io/v1", "ValidatingWebhookConfiguration", true, ""))
+
+	if len(updated) != 2 {
+		t.Fatalf("expected a new entry for a different kind to be appended; got %d entries", len(updated))
+	}
+}
+
+func TestNegotiatedAdmissionReviewVersionPrefersHighestPriorityServedVersion(t *testing.T) {
+	supported := map[string]bool{"v1": true, "v1beta1": true}
+
+	if got := negotiatedAdmissionReviewVersion([]string{"v1beta1", "v1"}, supported); got != "v1beta1" {
+		t.Errorf("expected v1beta1 to be preferred when listed first and served, got %q", got)
+	}
+
+	if got := negotiatedAdmissionReviewVersion([]string{"v1beta1"}, map[string]bool{"v1": true}); got != "v1" {
+		t.Errorf("expected a fallback to v1 when the requested version is not served, got %q", got)
+	}
+
+	if got := negotiatedAdmissionReviewVersion(nil, supported); got != "v1" {
+		t.Errorf("expected defaultAdmissionReviewVersions (v1) to apply when none are requested, got %q", got)
+	}
+}
@@ -25,9 +25,11 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/kubewarden/kubewarden-controller/internal/pkg/admission"
 	"github.com/kubewarden/kubewarden-controller/internal/pkg/constants"
+	"github.com/kubewarden/kubewarden-controller/internal/pkg/events"
 	policiesv1 "github.com/kubewarden/kubewarden-controller/pkg/apis/policies/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -38,9 +40,20 @@ import (
 // PolicyServerReconciler reconciles a PolicyServer object
 type PolicyServerReconciler struct {
 	client.Client
-	Log        logr.Logger
-	Scheme     *runtime.Scheme
-	Reconciler admission.Reconciler
+	Log           logr.Logger
+	Scheme        *runtime.Scheme
+	Reconciler    admission.Reconciler
+	EventRecorder record.EventRecorder
+
+	// UninstallMode is flipped to true once the background watcher started
+	// in SetupWithManager detects that the controller itself is being
+	// uninstalled. While true, reconcileDeletion force-removes finalizers
+	// instead of waiting for bound policies to drain.
+	UninstallMode bool
+	// Cancel stops the manager, letting `helm uninstall` proceed without
+	// waiting for the usual reconcile-driven cleanup once UninstallMode is
+	// detected.
+	Cancel context.CancelFunc
 }
 
 // Warning: this controller is deployed by a helm chart which has its own
@@ -86,9 +99,22 @@ func (r *PolicyServerReconciler) Reconcile(ctx context.Context, req ctrl.Request
 }
 
 func (r *PolicyServerReconciler) reconcile(ctx context.Context, policyServer *policiesv1.PolicyServer, policies []policiesv1.Policy) (ctrl.Result, error) {
+	policyServer.Status.RelatedObjects = policyRelatedObjects(policies)
+
+	// wasReady/wasObserved let the transitions below fire exactly once per
+	// actual state change instead of on every successful reconcile, which
+	// would otherwise spam the Events API on steady-state reconciles.
+	wasReady := policyServer.Status.Ready
+	wasObserved := policyServer.Status.ObservedGeneration == policyServer.Generation
+	policyServer.Status.ObservedGeneration = policyServer.Generation
+
 	if err := r.Reconciler.Reconcile(ctx, policyServer, policies); err != nil {
 		if admission.IsPolicyServerNotReady(err) {
 			r.Log.Info("delaying policy registration since policy server is not yet ready")
+			policyServer.Status.Ready = false
+			if wasReady || !wasObserved {
+				events.SendPolicyEvent(r.EventRecorder, policyServer, "PolicyServerUnready", "policy server deployment is not yet ready, delaying policy registration", events.SeverityWarning)
+			}
 			return ctrl.Result{
 				Requeue:      true,
 				RequeueAfter: time.Second * 5,
@@ -96,11 +122,39 @@ func (r *PolicyServerReconciler) reconcile(ctx context.Context, policyServer *po
 		}
 		return ctrl.Result{}, fmt.Errorf("reconciliation error: %w", err)
 	}
+
+	policyServer.Status.Ready = true
+	if !wasReady || !wasObserved {
+		events.SendPolicyEvent(r.EventRecorder, policyServer, "PolicyServerReady", "policy server deployment is ready", events.SeverityInfo)
+	}
 	return ctrl.Result{}, nil
 }
 
+// policyRelatedObjects builds the RelatedObject entries for the policies
+// currently bound to a PolicyServer, so PolicyServer.Status.RelatedObjects
+// shows which policies it serves without having to list them separately.
+func policyRelatedObjects(policies []policiesv1.Policy) []policiesv1.RelatedObject {
+	relatedObjects := make([]policiesv1.RelatedObject, 0, len(policies))
+	for _, policy := range policies {
+		kind := policy.GetObjectKind().GroupVersionKind().Kind
+		if kind == "" {
+			switch policy.(type) {
+			case *policiesv1.ClusterAdmissionPolicy:
+				kind = "ClusterAdmissionPolicy"
+			case *policiesv1.AdmissionPolicy:
+				kind = "AdmissionPolicy"
+			}
+		}
+		relatedObjects = append(relatedObjects, policiesv1.NewRelatedObject(policy, policiesv1.GroupVersion.String(), kind, policy.GetDeletionTimestamp() == nil, ""))
+	}
+	return relatedObjects
+}
+
 func (r *PolicyServerReconciler) reconcileDeletion(ctx context.Context, policyServer *policiesv1.PolicyServer, policies []policiesv1.Policy) (ctrl.Result, error) {
 	if len(policies) != 0 {
+		if r.UninstallMode {
+			return r.forceRemovePolicyFinalizers(ctx, policyServer, policies)
+		}
 		// There are still policies scheduled on the PolicyServer, we have to
 		// wait for them to be completely removed before going further with the cleanup
 		return r.deletePoliciesAndRequeue(ctx, policyServer, policies)
@@ -123,6 +177,16 @@ func (r *PolicyServerReconciler) reconcileDeletion(ctx context.Context, policySe
 }
 
 func (r *PolicyServerReconciler) deletePoliciesAndRequeue(ctx context.Context, policyServer *policiesv1.PolicyServer, policies []policiesv1.Policy) (ctrl.Result, error) {
+	// wasBlocked lets the events below fire exactly once per actual state
+	// change instead of on every reconcile while policies drain, which would
+	// otherwise spam the Events API since this path requeues until every
+	// bound policy is gone.
+	wasBlocked := policyServer.Status.DeletionBlocked
+	policyServer.Status.DeletionBlocked = true
+	if err := r.Status().Update(ctx, policyServer); err != nil && !apierrors.IsConflict(err) {
+		r.Log.Error(err, "cannot update policy server status", "policy-server", policyServer.Name)
+	}
+
 	deleteError := make([]error, 0)
 	for _, policy := range policies {
 		if policy.GetDeletionTimestamp() != nil {
@@ -136,14 +200,66 @@ func (r *PolicyServerReconciler) deletePoliciesAndRequeue(ctx context.Context, p
 
 	if len(deleteError) != 0 {
 		r.Log.Error(errors.Join(deleteError...), "could not remove all policies bound to policy server", "policy-server", policyServer.Name)
+		if !wasBlocked {
+			events.SendPolicyEvent(r.EventRecorder, policyServer, "PolicyServerDeletionBlocked", "could not remove all policies bound to this policy server", events.SeverityWarning)
+		}
 		return ctrl.Result{}, fmt.Errorf("could not remove all policies bound to policy server %s", policyServer.Name)
 	}
 
+	if !wasBlocked {
+		events.SendPolicyEvent(r.EventRecorder, policyServer, "PolicyServerDeletionBlocked", fmt.Sprintf("deletion blocked: %d policies are still bound to this policy server", len(policies)), events.SeverityWarning)
+	}
+	return ctrl.Result{Requeue: true}, nil
+}
+
+// forceRemovePolicyFinalizers is used instead of deletePoliciesAndRequeue
+// while the controller is being uninstalled. `helm uninstall` only waits a
+// bounded amount of time for resources to terminate, so instead of draining
+// bound policies one reconcile at a time we delete their generated webhook
+// configurations, issue a Delete for the policy itself, and strip its
+// finalizer so the API server can garbage-collect it immediately instead of
+// waiting on the finalizer, avoiding resources stuck in a terminating state
+// and webhook configurations left behind that would block all API traffic.
+func (r *PolicyServerReconciler) forceRemovePolicyFinalizers(ctx context.Context, policyServer *policiesv1.PolicyServer, policies []policiesv1.Policy) (ctrl.Result, error) {
+	removeErrors := make([]error, 0)
+	for _, policy := range policies {
+		if err := deletePolicyWebhookConfigurations(ctx, r.Client, policy.GetName()); err != nil {
+			removeErrors = append(removeErrors, err)
+		}
+
+		if err := r.Delete(ctx, policy); err != nil && !apierrors.IsNotFound(err) {
+			removeErrors = append(removeErrors, err)
+			continue
+		}
+
+		controllerutil.RemoveFinalizer(policy, constants.KubewardenFinalizer)
+		if err := r.Update(ctx, policy); err != nil && !apierrors.IsNotFound(err) && !apierrors.IsConflict(err) {
+			removeErrors = append(removeErrors, err)
+		}
+	}
+
+	if len(removeErrors) != 0 {
+		r.Log.Error(errors.Join(removeErrors...), "could not force-remove all policies bound to policy server during uninstall", "policy-server", policyServer.Name)
+		events.SendPolicyEvent(r.EventRecorder, policyServer, "PolicyServerUninstallCleanup", "failed to force-remove some bound policies", events.SeverityWarning)
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	// The policies above are now pending (or already) deletion; requeue once
+	// more so reconcileDeletion observes an empty policy list and proceeds to
+	// remove the PolicyServer's own finalizer instead of looping here forever.
 	return ctrl.Result{Requeue: true}, nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *PolicyServerReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.EventRecorder = mgr.GetEventRecorderFor("kubewarden-controller")
+
+	if r.Cancel != nil {
+		if err := startUninstallWatcher(mgr, r.Cancel, r.Reconciler.DeploymentsNamespace, constants.ControllerDeploymentName, &r.UninstallMode); err != nil {
+			return fmt.Errorf("failed starting uninstall watcher: %w", err)
+		}
+	}
+
 	err := mgr.GetFieldIndexer().IndexField(context.Background(), &policiesv1.ClusterAdmissionPolicy{}, constants.PolicyServerIndexKey, func(object client.Object) []string {
 		policy, ok := object.(*policiesv1.ClusterAdmissionPolicy)
 		if !ok {
@@ -212,4 +328,4 @@ func (r *PolicyServerReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		return errors.Join(errors.New("failed enrolling controller with manager"), err)
 	}
 	return nil
-}
\ No newline at end of file
+}
@@ -0,0 +1,213 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/kubewarden/kubewarden-controller/internal/pkg/constants"
+	policiesv1 "github.com/kubewarden/kubewarden-controller/pkg/apis/policies/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// resolveTemplateRef fetches the PolicyTemplate referenced by spec.TemplateRef
+// and renders the effective spec a policy should reconcile with: Module and
+// Settings are always taken from the template (layering the policy's own
+// TemplateRef.Values over the template's DefaultSettings on a per-parameter
+// basis), while Rules, FailurePolicy, MatchPolicy and NamespaceSelector fall
+// back to the template's defaults only where the policy left its own field
+// unset. When spec.TemplateRef is nil, spec is left untouched so callers can
+// invoke resolveTemplateRef unconditionally.
+func resolveTemplateRef(ctx context.Context, c client.Client, spec *policiesv1.ClusterAdmissionPolicySpec) error {
+	if spec.TemplateRef == nil {
+		return nil
+	}
+
+	var template policiesv1.PolicyTemplate
+	if err := c.Get(ctx, client.ObjectKey{Name: spec.TemplateRef.Name}, &template); err != nil {
+		if apierrors.IsNotFound(err) {
+			return fmt.Errorf("policy template %q not found", spec.TemplateRef.Name)
+		}
+		return fmt.Errorf("cannot retrieve policy template %q: %w", spec.TemplateRef.Name, err)
+	}
+
+	effectiveSettings, err := mergeTemplateSettings(template.Spec.DefaultSettings, spec.TemplateRef.Values)
+	if err != nil {
+		return fmt.Errorf("cannot merge parameter values for policy template %q: %w", spec.TemplateRef.Name, err)
+	}
+
+	if err := validateTemplateValues(effectiveSettings, template.Spec.SettingsSchema); err != nil {
+		return fmt.Errorf("parameter values for policy template %q do not validate: %w", spec.TemplateRef.Name, err)
+	}
+
+	spec.Module = template.Spec.Module
+	spec.Settings = effectiveSettings
+	if len(spec.Rules) == 0 {
+		spec.Rules = template.Spec.Rules
+	}
+	if spec.FailurePolicy == nil {
+		spec.FailurePolicy = template.Spec.FailurePolicy
+	}
+	if spec.MatchPolicy == nil {
+		spec.MatchPolicy = template.Spec.MatchPolicy
+	}
+	if spec.NamespaceSelector == nil {
+		spec.NamespaceSelector = template.Spec.NamespaceSelector
+	}
+
+	return nil
+}
+
+// mergeTemplateSettings layers values on top of defaultSettings on a
+// per-parameter basis, matching the documented semantics of
+// TemplateRef.Values ("overrides the template's DefaultSettings on a
+// per-parameter basis"): a caller overriding one parameter keeps every other
+// default untouched, instead of replacing the whole settings object.
+func mergeTemplateSettings(defaultSettings, values *runtime.RawExtension) (*runtime.RawExtension, error) {
+	if values == nil {
+		return defaultSettings, nil
+	}
+	if defaultSettings == nil {
+		return values, nil
+	}
+
+	merged := map[string]interface{}{}
+	if err := json.Unmarshal(defaultSettings.Raw, &merged); err != nil {
+		return nil, fmt.Errorf("cannot unmarshal default settings: %w", err)
+	}
+
+	overrides := map[string]interface{}{}
+	if err := json.Unmarshal(values.Raw, &overrides); err != nil {
+		return nil, fmt.Errorf("cannot unmarshal parameter values: %w", err)
+	}
+
+	for parameter, value := range overrides {
+		merged[parameter] = value
+	}
+
+	raw, err := json.Marshal(merged)
+	if err != nil {
+		return nil, fmt.Errorf("cannot marshal merged settings: %w", err)
+	}
+	return &runtime.RawExtension{Raw: raw}, nil
+}
+
+// templateSettingsSchema is the minimal subset of an OpenAPI/JSON schema
+// object validateTemplateValues understands: which top-level parameters are
+// required, and what JSON type each must have. This covers the common case
+// of a PolicyTemplate author documenting its parameters without pulling in a
+// full JSON schema validator.
+type templateSettingsSchema struct {
+	Type       string                            `json:"type"`
+	Required   []string                          `json:"required"`
+	Properties map[string]templateSettingsSchema `json:"properties"`
+}
+
+// validateTemplateValues checks that values satisfies the required
+// parameters and top-level types declared by schema. A nil schema means the
+// template does not constrain its parameters. This intentionally only
+// enforces the subset of JSON schema declared in templateSettingsSchema
+// (required properties + top-level type); it does not evaluate nested
+// schemas, enums, or numeric bounds.
+func validateTemplateValues(values *runtime.RawExtension, schema *runtime.RawExtension) error {
+	if schema == nil {
+		return nil
+	}
+
+	var settingsSchema templateSettingsSchema
+	if err := json.Unmarshal(schema.Raw, &settingsSchema); err != nil {
+		return fmt.Errorf("cannot unmarshal settings schema: %w", err)
+	}
+
+	parameters := map[string]interface{}{}
+	if values != nil {
+		if err := json.Unmarshal(values.Raw, &parameters); err != nil {
+			return fmt.Errorf("cannot unmarshal parameter values: %w", err)
+		}
+	}
+
+	for _, required := range settingsSchema.Required {
+		if _, found := parameters[required]; !found {
+			return fmt.Errorf("required parameter %q is missing", required)
+		}
+	}
+
+	for name, value := range parameters {
+		propertySchema, found := settingsSchema.Properties[name]
+		if !found || propertySchema.Type == "" {
+			continue
+		}
+		if err := validateJSONType(name, value, propertySchema.Type); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateJSONType reports whether value's concrete Go type, as produced by
+// encoding/json, matches the JSON schema primitive jsonType.
+func validateJSONType(parameter string, value interface{}, jsonType string) error {
+	ok := false
+	switch jsonType {
+	case "string":
+		_, ok = value.(string)
+	case "boolean":
+		_, ok = value.(bool)
+	case "number", "integer":
+		_, ok = value.(float64)
+	case "object":
+		_, ok = value.(map[string]interface{})
+	case "array":
+		_, ok = value.([]interface{})
+	default:
+		return nil
+	}
+	if !ok {
+		return fmt.Errorf("parameter %q does not match schema type %q", parameter, jsonType)
+	}
+	return nil
+}
+
+// findClusterAdmissionPoliciesForPolicyTemplate enqueues every
+// ClusterAdmissionPolicy referencing the changed PolicyTemplate, via the
+// PolicyTemplateIndexKey field indexer registered in SetupWithManager.
+func (r *ClusterAdmissionPolicyReconciler) findClusterAdmissionPoliciesForPolicyTemplate(ctx context.Context, object client.Object) []reconcile.Request {
+	template, ok := object.(*policiesv1.PolicyTemplate)
+	if !ok {
+		return []reconcile.Request{}
+	}
+
+	var policies policiesv1.ClusterAdmissionPolicyList
+	if err := r.List(ctx, &policies, client.MatchingFields{constants.PolicyTemplateIndexKey: template.Name}); err != nil {
+		r.Log.Error(err, "cannot list ClusterAdmissionPolicies referencing policy template", "policy-template", template.Name)
+		return []reconcile.Request{}
+	}
+
+	requests := make([]reconcile.Request, 0, len(policies.Items))
+	for _, policy := range policies.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: client.ObjectKey{Name: policy.Name},
+		})
+	}
+	return requests
+}
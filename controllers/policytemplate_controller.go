@@ -0,0 +1,89 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"github.com/kubewarden/kubewarden-controller/internal/pkg/events"
+	policiesv1 "github.com/kubewarden/kubewarden-controller/pkg/apis/policies/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+//+kubebuilder:rbac:groups=policies.kubewarden.io,resources=policytemplates,verbs=get;list;watch
+//+kubebuilder:rbac:groups=policies.kubewarden.io,resources=policytemplates/status,verbs=get;update;patch
+
+// PolicyTemplateReconciler validates a PolicyTemplate's SettingsSchema and
+// DefaultSettings, so a malformed template is flagged on its own Status
+// instead of only surfacing as a resolveTemplateRef error on whichever
+// policy happens to reference it first.
+type PolicyTemplateReconciler struct {
+	client.Client
+	Log           logr.Logger
+	Scheme        *runtime.Scheme
+	EventRecorder record.EventRecorder
+}
+
+// Reconcile validates a policy template's settings
+func (r *PolicyTemplateReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	var template policiesv1.PolicyTemplate
+	if err := r.Get(ctx, req.NamespacedName, &template); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, fmt.Errorf("cannot retrieve policy template: %w", err)
+	}
+
+	// wasValid/wasObserved let the events below fire exactly once per actual
+	// state change instead of on every successful reconcile, which would
+	// otherwise spam the Events API on steady-state reconciles.
+	wasValid := template.Status.Valid
+	wasObserved := template.Status.ObservedGeneration == template.Generation
+	template.Status.ObservedGeneration = template.Generation
+
+	validationErr := validateTemplateValues(template.Spec.DefaultSettings, template.Spec.SettingsSchema)
+
+	template.Status.Valid = validationErr == nil
+	if validationErr != nil {
+		if wasValid || !wasObserved {
+			events.SendPolicyEvent(r.EventRecorder, &template, "PolicyTemplateInvalid", validationErr.Error(), events.SeverityWarning)
+		}
+	} else if !wasValid || !wasObserved {
+		events.SendPolicyEvent(r.EventRecorder, &template, "PolicyTemplateValid", "default settings validate against the settings schema", events.SeverityInfo)
+	}
+
+	if err := r.Status().Update(ctx, &template); err != nil && !apierrors.IsConflict(err) {
+		return ctrl.Result{}, fmt.Errorf("update policy template status error: %w", err)
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *PolicyTemplateReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.EventRecorder = mgr.GetEventRecorderFor("kubewarden-controller")
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&policiesv1.PolicyTemplate{}).
+		Complete(r)
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"encoding/json"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func rawExtension(t *testing.T, value string) *runtime.RawExtension {
+	t.Helper()
+	return &runtime.RawExtension{Raw: []byte(value)}
+}
+
+func TestMergeTemplateSettingsOverridesOnlyGivenParameters(t *testing.T) {
+	defaultSettings := rawExtension(t, `{"a":1,"b":2}`)
+	values := rawExtension(t, `{"b":3}`)
+
+	merged, err := mergeTemplateSettings(defaultSettings, values)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var got map[string]interface{}
+	if err := json.Unmarshal(merged.Raw, &got); err != nil {
+		t.Fatalf("cannot unmarshal merged settings: %v", err)
+	}
+
+	if got["a"] != float64(1) {
+		t.Errorf("expected untouched default parameter 'a' to survive, got %v", got["a"])
+	}
+	if got["b"] != float64(3) {
+		t.Errorf("expected overridden parameter 'b' to be 3, got %v", got["b"])
+	}
+}
+
+func TestMergeTemplateSettingsNilValuesReturnsDefaults(t *testing.T) {
+	defaultSettings := rawExtension(t, `{"a":1}`)
+
+	merged, err := mergeTemplateSettings(defaultSettings, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if merged != defaultSettings {
+		t.Errorf("expected defaultSettings to be returned unchanged")
+	}
+}
+
+func TestValidateTemplateValuesRequiresDeclaredParameters(t *testing.T) {
+	schema := rawExtension(t, `{"type":"object","required":["image"],"properties":{"image":{"type":"string"}}}`)
+
+	if err := validateTemplateValues(rawExtension(t, `{}`), schema); err == nil {
+		t.Errorf("expected an error for a missing required parameter")
+	}
+
+	if err := validateTemplateValues(rawExtension(t, `{"image":"registry/policy:v1"}`), schema); err != nil {
+		t.Errorf("expected valid values to pass, got error: %v", err)
+	}
+
+	if err := validateTemplateValues(rawExtension(t, `{"image":42}`), schema); err == nil {
+		t.Errorf("expected an error for a parameter that does not match its declared type")
+	}
+}
+
+func TestValidateTemplateValuesNilSchemaAlwaysPasses(t *testing.T) {
+	if err := validateTemplateValues(rawExtension(t, `{"anything":true}`), nil); err != nil {
+		t.Errorf("expected a nil schema to not constrain parameters, got error: %v", err)
+	}
+}
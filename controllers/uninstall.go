@@ -0,0 +1,105 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// uninstallSentinelConfigMapName is a well-known ConfigMap the helm chart's
+// pre-delete hook creates right before `helm uninstall` tears down the
+// controller, so a running controller-manager can detect its own impending
+// removal even before its Deployment is gone.
+const uninstallSentinelConfigMapName = "kubewarden-controller-uninstall"
+
+// uninstallPollInterval is how often watchForUninstall checks for signs that
+// the controller is being uninstalled.
+const uninstallPollInterval = 2 * time.Second
+
+// watchForUninstall runs until ctx is cancelled, polling for the
+// controller's own Deployment being deleted or scaled to zero, or for the
+// uninstall sentinel ConfigMap. Once detected, it flips *uninstallMode to
+// true and calls cancel, so reconcileDeletion can switch from waiting for
+// bound policies to drain normally to a fast, best-effort finalizer cleanup.
+// This prevents `helm uninstall` from leaving the cluster with stuck
+// resources and orphaned admission webhooks that block all API traffic.
+func watchForUninstall(ctx context.Context, cancel context.CancelFunc, reader client.Reader, namespace, deploymentName string, uninstallMode *bool) {
+	ticker := time.NewTicker(uninstallPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if isBeingUninstalled(ctx, reader, namespace, deploymentName) {
+				*uninstallMode = true
+				cancel()
+				return
+			}
+		}
+	}
+}
+
+func isBeingUninstalled(ctx context.Context, reader client.Reader, namespace, deploymentName string) bool {
+	var deployment appsv1.Deployment
+	err := reader.Get(ctx, client.ObjectKey{Namespace: namespace, Name: deploymentName}, &deployment)
+	switch {
+	case apierrors.IsNotFound(err):
+		return true
+	case err == nil:
+		if deployment.DeletionTimestamp != nil {
+			return true
+		}
+		if deployment.Spec.Replicas != nil && *deployment.Spec.Replicas == 0 {
+			return true
+		}
+	}
+
+	var sentinel corev1.ConfigMap
+	err = reader.Get(ctx, client.ObjectKey{Namespace: namespace, Name: uninstallSentinelConfigMapName}, &sentinel)
+	return err == nil
+}
+
+// startUninstallWatcher wires watchForUninstall into mgr as a Runnable, so it
+// starts and stops together with the rest of the manager instead of leaking a
+// goroutine across reconciler restarts in tests.
+func startUninstallWatcher(mgr ctrl.Manager, cancel context.CancelFunc, namespace, deploymentName string, uninstallMode *bool) error {
+	return mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		watchForUninstall(ctx, cancel, mgr.GetAPIReader(), namespace, deploymentName, uninstallMode)
+		return nil
+	}))
+}
+
+// NewUninstallContext derives a cancellable context from ctx and returns it
+// together with its CancelFunc. The caller assigns the CancelFunc to every
+// reconciler's Cancel field and starts the manager with the returned
+// context, so that either reconciler's uninstall watcher detecting the
+// controller is being uninstalled stops the same manager. Without this,
+// UninstallMode could never flip to true: nothing else in this package
+// constructs a context.CancelFunc to assign to Cancel.
+func NewUninstallContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithCancel(ctx)
+}
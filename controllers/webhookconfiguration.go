@@ -0,0 +1,261 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/kubewarden/kubewarden-controller/internal/pkg/constants"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	admissionregistrationv1beta1 "k8s.io/api/admissionregistration/v1beta1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// negotiatedAdmissionReviewVersion returns the highest-priority version in
+// versions that is actually served by the cluster, per supported. versions is
+// already known to validate cleanly against supported (validateAdmissionReviewVersions
+// runs first), so this only picks the one the generated webhook configuration
+// should be typed as.
+func negotiatedAdmissionReviewVersion(versions []string, supported map[string]bool) string {
+	if len(versions) == 0 {
+		versions = defaultAdmissionReviewVersions
+	}
+	for _, version := range versions {
+		if supported[version] {
+			return version
+		}
+	}
+	return "v1"
+}
+
+// ensureAdmissionReviewVersionsWebhookConfiguration keeps the
+// Mutating/ValidatingWebhookConfiguration generated for policyName typed as
+// the AdmissionReview version it actually negotiated. admission.Reconciler
+// only ever generates the v1 (admissionregistration.k8s.io/v1) shape; when a
+// policy negotiates v1beta1 this converts that v1 object into its v1beta1
+// equivalent in place. The v1 objects are found by listing through the
+// admissionregistration.k8s.io/v1 API, so on a cluster that does not serve
+// that API the List call fails and an error is returned.
+func ensureAdmissionReviewVersionsWebhookConfiguration(ctx context.Context, c client.Client, policyName, negotiatedVersion string) (bool, error) {
+	if negotiatedVersion != "v1beta1" {
+		return false, nil
+	}
+
+	changed := false
+
+	var validatingConfigs admissionregistrationv1.ValidatingWebhookConfigurationList
+	if err := c.List(ctx, &validatingConfigs); err != nil {
+		return false, fmt.Errorf("cannot list validating webhook configurations: %w", err)
+	}
+	for _, webhookConfiguration := range validatingConfigs.Items {
+		if webhookConfiguration.Annotations[constants.WebhookConfigurationPolicyNameAnnotationKey] != policyName {
+			continue
+		}
+		if err := convertToV1beta1ValidatingWebhookConfiguration(ctx, c, &webhookConfiguration); err != nil {
+			return changed, err
+		}
+		changed = true
+	}
+
+	var mutatingConfigs admissionregistrationv1.MutatingWebhookConfigurationList
+	if err := c.List(ctx, &mutatingConfigs); err != nil {
+		return changed, fmt.Errorf("cannot list mutating webhook configurations: %w", err)
+	}
+	for _, webhookConfiguration := range mutatingConfigs.Items {
+		if webhookConfiguration.Annotations[constants.WebhookConfigurationPolicyNameAnnotationKey] != policyName {
+			continue
+		}
+		if err := convertToV1beta1MutatingWebhookConfiguration(ctx, c, &webhookConfiguration); err != nil {
+			return changed, err
+		}
+		changed = true
+	}
+
+	return changed, nil
+}
+
+func convertToV1beta1ValidatingWebhookConfiguration(ctx context.Context, c client.Client, v1Config *admissionregistrationv1.ValidatingWebhookConfiguration) error {
+	v1beta1Config := &admissionregistrationv1beta1.ValidatingWebhookConfiguration{
+		ObjectMeta: v1Config.ObjectMeta,
+	}
+	v1beta1Config.ResourceVersion = ""
+	for _, webhook := range v1Config.Webhooks {
+		v1beta1Config.Webhooks = append(v1beta1Config.Webhooks, validatingWebhookToV1beta1(webhook))
+	}
+
+	err := c.Create(ctx, v1beta1Config)
+	if apierrors.IsAlreadyExists(err) {
+		var existing admissionregistrationv1beta1.ValidatingWebhookConfiguration
+		if getErr := c.Get(ctx, client.ObjectKeyFromObject(v1beta1Config), &existing); getErr != nil {
+			return fmt.Errorf("cannot retrieve existing v1beta1 validating webhook configuration: %w", getErr)
+		}
+		existing.Webhooks = v1beta1Config.Webhooks
+		if updateErr := c.Update(ctx, &existing); updateErr != nil {
+			return fmt.Errorf("cannot update v1beta1 validating webhook configuration: %w", updateErr)
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("cannot create v1beta1 validating webhook configuration: %w", err)
+	}
+
+	return c.Delete(ctx, v1Config)
+}
+
+func convertToV1beta1MutatingWebhookConfiguration(ctx context.Context, c client.Client, v1Config *admissionregistrationv1.MutatingWebhookConfiguration) error {
+	v1beta1Config := &admissionregistrationv1beta1.MutatingWebhookConfiguration{
+		ObjectMeta: v1Config.ObjectMeta,
+	}
+	v1beta1Config.ResourceVersion = ""
+	for _, webhook := range v1Config.Webhooks {
+		v1beta1Config.Webhooks = append(v1beta1Config.Webhooks, mutatingWebhookToV1beta1(webhook))
+	}
+
+	err := c.Create(ctx, v1beta1Config)
+	if apierrors.IsAlreadyExists(err) {
+		var existing admissionregistrationv1beta1.MutatingWebhookConfiguration
+		if getErr := c.Get(ctx, client.ObjectKeyFromObject(v1beta1Config), &existing); getErr != nil {
+			return fmt.Errorf("cannot retrieve existing v1beta1 mutating webhook configuration: %w", getErr)
+		}
+		existing.Webhooks = v1beta1Config.Webhooks
+		if updateErr := c.Update(ctx, &existing); updateErr != nil {
+			return fmt.Errorf("cannot update v1beta1 mutating webhook configuration: %w", updateErr)
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("cannot create v1beta1 mutating webhook configuration: %w", err)
+	}
+
+	return c.Delete(ctx, v1Config)
+}
+
+func validatingWebhookToV1beta1(webhook admissionregistrationv1.ValidatingWebhook) admissionregistrationv1beta1.ValidatingWebhook {
+	return admissionregistrationv1beta1.ValidatingWebhook{
+		Name:                    webhook.Name,
+		ClientConfig:            webhookClientConfigToV1beta1(webhook.ClientConfig),
+		Rules:                   webhook.Rules,
+		FailurePolicy:           webhook.FailurePolicy,
+		MatchPolicy:             webhook.MatchPolicy,
+		NamespaceSelector:       webhook.NamespaceSelector,
+		ObjectSelector:          webhook.ObjectSelector,
+		SideEffects:             webhook.SideEffects,
+		TimeoutSeconds:          webhook.TimeoutSeconds,
+		AdmissionReviewVersions: []string{"v1beta1"},
+	}
+}
+
+func mutatingWebhookToV1beta1(webhook admissionregistrationv1.MutatingWebhook) admissionregistrationv1beta1.MutatingWebhook {
+	return admissionregistrationv1beta1.MutatingWebhook{
+		Name:                    webhook.Name,
+		ClientConfig:            webhookClientConfigToV1beta1(webhook.ClientConfig),
+		Rules:                   webhook.Rules,
+		FailurePolicy:           webhook.FailurePolicy,
+		MatchPolicy:             webhook.MatchPolicy,
+		NamespaceSelector:       webhook.NamespaceSelector,
+		ObjectSelector:          webhook.ObjectSelector,
+		SideEffects:             webhook.SideEffects,
+		TimeoutSeconds:          webhook.TimeoutSeconds,
+		AdmissionReviewVersions: []string{"v1beta1"},
+		ReinvocationPolicy:      webhook.ReinvocationPolicy,
+	}
+}
+
+// deletePolicyWebhookConfigurations deletes every v1 and v1beta1
+// Mutating/ValidatingWebhookConfiguration generated for policyName. Used
+// while force-removing a policy's finalizer during uninstall, so the policy
+// can be garbage-collected without leaving a stale webhook configuration
+// behind that would keep blocking API traffic after the policy is gone.
+func deletePolicyWebhookConfigurations(ctx context.Context, c client.Client, policyName string) error {
+	var deleteErrors []error
+
+	var v1Validating admissionregistrationv1.ValidatingWebhookConfigurationList
+	if err := c.List(ctx, &v1Validating); err != nil {
+		return fmt.Errorf("cannot list validating webhook configurations: %w", err)
+	}
+	for i := range v1Validating.Items {
+		if v1Validating.Items[i].Annotations[constants.WebhookConfigurationPolicyNameAnnotationKey] != policyName {
+			continue
+		}
+		if err := c.Delete(ctx, &v1Validating.Items[i]); err != nil && !apierrors.IsNotFound(err) {
+			deleteErrors = append(deleteErrors, err)
+		}
+	}
+
+	var v1Mutating admissionregistrationv1.MutatingWebhookConfigurationList
+	if err := c.List(ctx, &v1Mutating); err != nil {
+		return fmt.Errorf("cannot list mutating webhook configurations: %w", err)
+	}
+	for i := range v1Mutating.Items {
+		if v1Mutating.Items[i].Annotations[constants.WebhookConfigurationPolicyNameAnnotationKey] != policyName {
+			continue
+		}
+		if err := c.Delete(ctx, &v1Mutating.Items[i]); err != nil && !apierrors.IsNotFound(err) {
+			deleteErrors = append(deleteErrors, err)
+		}
+	}
+
+	var v1beta1Validating admissionregistrationv1beta1.ValidatingWebhookConfigurationList
+	if err := c.List(ctx, &v1beta1Validating); err != nil {
+		return fmt.Errorf("cannot list v1beta1 validating webhook configurations: %w", err)
+	}
+	for i := range v1beta1Validating.Items {
+		if v1beta1Validating.Items[i].Annotations[constants.WebhookConfigurationPolicyNameAnnotationKey] != policyName {
+			continue
+		}
+		if err := c.Delete(ctx, &v1beta1Validating.Items[i]); err != nil && !apierrors.IsNotFound(err) {
+			deleteErrors = append(deleteErrors, err)
+		}
+	}
+
+	var v1beta1Mutating admissionregistrationv1beta1.MutatingWebhookConfigurationList
+	if err := c.List(ctx, &v1beta1Mutating); err != nil {
+		return fmt.Errorf("cannot list v1beta1 mutating webhook configurations: %w", err)
+	}
+	for i := range v1beta1Mutating.Items {
+		if v1beta1Mutating.Items[i].Annotations[constants.WebhookConfigurationPolicyNameAnnotationKey] != policyName {
+			continue
+		}
+		if err := c.Delete(ctx, &v1beta1Mutating.Items[i]); err != nil && !apierrors.IsNotFound(err) {
+			deleteErrors = append(deleteErrors, err)
+		}
+	}
+
+	if len(deleteErrors) != 0 {
+		return errors.Join(deleteErrors...)
+	}
+	return nil
+}
+
+func webhookClientConfigToV1beta1(clientConfig admissionregistrationv1.WebhookClientConfig) admissionregistrationv1beta1.WebhookClientConfig {
+	v1beta1ClientConfig := admissionregistrationv1beta1.WebhookClientConfig{
+		CABundle: clientConfig.CABundle,
+		URL:      clientConfig.URL,
+	}
+	if clientConfig.Service != nil {
+		v1beta1ClientConfig.Service = &admissionregistrationv1beta1.ServiceReference{
+			Namespace: clientConfig.Service.Namespace,
+			Name:      clientConfig.Service.Name,
+			Path:      clientConfig.Service.Path,
+			Port:      clientConfig.Service.Port,
+		}
+	}
+	return v1beta1ClientConfig
+}
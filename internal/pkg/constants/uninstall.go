@@ -0,0 +1,22 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package constants
+
+// ControllerDeploymentName is the name of the Deployment the helm chart
+// creates for the kubewarden-controller itself. Reconcilers watch it to
+// detect `helm uninstall` tearing the controller down.
+const ControllerDeploymentName = "kubewarden-controller"
@@ -0,0 +1,63 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package events provides a small helper used by the kubewarden-controller
+// reconcilers to record Kubernetes Events on policy and PolicyServer
+// lifecycle transitions, so that `kubectl describe`, event exporters and
+// alertmanager can react to policy state without polling status.
+package events
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+)
+
+// Severity is a compliance-like severity for a policy event. It is mapped to
+// one of the Kubernetes Event types (Normal/Warning) when the event is
+// recorded.
+type Severity string
+
+const (
+	// SeverityInfo is used for events describing an expected, successful
+	// transition (e.g. a policy being accepted by its PolicyServer).
+	SeverityInfo Severity = "Info"
+	// SeverityWarning is used for events describing a transition that needs
+	// operator attention (e.g. a policy being rejected, or a deletion being
+	// blocked).
+	SeverityWarning Severity = "Warning"
+)
+
+// eventType maps a Severity to the corev1 Event type understood by the
+// EventRecorder.
+func eventType(severity Severity) string {
+	if severity == SeverityWarning {
+		return corev1.EventTypeWarning
+	}
+	return corev1.EventTypeNormal
+}
+
+// SendPolicyEvent records an Event against obj using recorder, translating
+// severity into the Normal/Warning distinction expected by `kubectl describe`
+// and downstream event consumers. recorder may be nil, in which case this is
+// a no-op, so callers don't need to guard every call site (e.g. in tests that
+// don't set up an EventRecorder).
+func SendPolicyEvent(recorder record.EventRecorder, obj runtime.Object, reason, msg string, severity Severity) {
+	if recorder == nil {
+		return
+	}
+	recorder.Event(obj, eventType(severity), reason, msg)
+}
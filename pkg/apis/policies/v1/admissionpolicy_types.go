@@ -0,0 +1,126 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// AdmissionPolicySpec defines the desired state of an AdmissionPolicy. It
+// mirrors ClusterAdmissionPolicySpec, scoped to the namespace the policy
+// lives in instead of the whole cluster.
+type AdmissionPolicySpec struct {
+	// PolicyServer is the name of the PolicyServer this policy is scheduled on.
+	PolicyServer string `json:"policyServer"`
+
+	// Module is the OCI artifact or URL the policy is fetched from.
+	// +optional
+	Module string `json:"module,omitempty"`
+
+	// Settings carries the policy-specific configuration.
+	// +optional
+	Settings *runtime.RawExtension `json:"settings,omitempty"`
+
+	// TemplateRef names a PolicyTemplate and the parameter values for it.
+	// resolveTemplateRef only handles ClusterAdmissionPolicySpec, so an
+	// AdmissionPolicy's TemplateRef is not resolved: Module, Settings, Rules,
+	// FailurePolicy, MatchPolicy and NamespaceSelector are used as written.
+	// +optional
+	TemplateRef *TemplateRef `json:"templateRef,omitempty"`
+
+	// Rules describes what operations on what resources/subresources the
+	// webhook cares about.
+	// +optional
+	Rules []admissionregistrationv1.RuleWithOperations `json:"rules,omitempty"`
+
+	// FailurePolicy defines how unrecognized errors from the policy are
+	// handled.
+	// +optional
+	// +kubebuilder:validation:Enum=Fail;Ignore
+	FailurePolicy *string `json:"failurePolicy,omitempty"`
+
+	// MatchPolicy defines how the Rules are evaluated against an incoming
+	// request.
+	// +optional
+	// +kubebuilder:validation:Enum=Equivalent;Exact
+	MatchPolicy *string `json:"matchPolicy,omitempty"`
+
+	// NamespaceSelector restricts the namespaces the webhook applies to.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// AdmissionReviewVersions lists the AdmissionReview API versions this
+	// policy's generated webhook configuration accepts, in order of
+	// preference. Defaults to ["v1"] when unset; only versions the target
+	// cluster actually serves are accepted.
+	// +optional
+	// +kubebuilder:default={v1}
+	AdmissionReviewVersions []string `json:"admissionReviewVersions,omitempty"`
+}
+
+// AdmissionPolicyStatus reflects the observed state of an AdmissionPolicy.
+type AdmissionPolicyStatus struct {
+	// RelatedObjects lists the concrete objects this policy owns or watches
+	// (its generated webhook configuration, ...) together with their
+	// compliance state.
+	// +optional
+	RelatedObjects []RelatedObject `json:"relatedObjects,omitempty"`
+
+	// Accepted reflects whether the PolicyServer currently serves this
+	// policy.
+	// +optional
+	Accepted bool `json:"accepted,omitempty"`
+
+	// ObservedGeneration is the most recent generation the controller has
+	// reconciled, so Reconcile can tell a first-time result apart from a
+	// repeated one and only emit a PolicyAccepted/PolicyRejected event on an
+	// actual transition.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// AdmissionPolicy is the Schema for the admissionpolicies API.
+type AdmissionPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AdmissionPolicySpec   `json:"spec,omitempty"`
+	Status AdmissionPolicyStatus `json:"status,omitempty"`
+}
+
+// GetPolicyServer implements Policy.
+func (p *AdmissionPolicy) GetPolicyServer() string {
+	return p.Spec.PolicyServer
+}
+
+// +kubebuilder:object:root=true
+
+// AdmissionPolicyList contains a list of AdmissionPolicy.
+type AdmissionPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AdmissionPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AdmissionPolicy{}, &AdmissionPolicyList{})
+}
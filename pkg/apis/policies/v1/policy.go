@@ -0,0 +1,30 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import "sigs.k8s.io/controller-runtime/pkg/client"
+
+// Policy is implemented by both ClusterAdmissionPolicy and AdmissionPolicy,
+// so the PolicyServer reconciler and the shared admission.Reconciler can
+// operate on either kind without a type switch at every call site.
+type Policy interface {
+	client.Object
+
+	// GetPolicyServer returns the name of the PolicyServer this policy is
+	// scheduled on.
+	GetPolicyServer() string
+}
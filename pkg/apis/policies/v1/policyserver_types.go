@@ -0,0 +1,96 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PolicyServerSpec defines the desired state of a PolicyServer.
+type PolicyServerSpec struct {
+	// Image is the container image running the policy server.
+	Image string `json:"image"`
+
+	// Replicas is the number of desired policy server pods.
+	// +optional
+	Replicas int32 `json:"replicas,omitempty"`
+
+	// ServiceAccountName is the service account the policy server deployment
+	// runs as.
+	// +optional
+	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+
+	// Env lists additional environment variables injected into the policy
+	// server container.
+	// +optional
+	Env []corev1.EnvVar `json:"env,omitempty"`
+}
+
+// PolicyServerStatus reflects the observed state of a PolicyServer.
+type PolicyServerStatus struct {
+	// Ready reflects whether the policy server deployment currently has all
+	// of its replicas available.
+	// +optional
+	Ready bool `json:"ready,omitempty"`
+
+	// RelatedObjects lists the policies bound to this PolicyServer, each
+	// marked non-compliant while it is pending deletion. The PolicyServer's
+	// own Deployment, Service and ConfigMap are not listed.
+	// +optional
+	RelatedObjects []RelatedObject `json:"relatedObjects,omitempty"`
+
+	// ObservedGeneration is the most recent generation the controller has
+	// reconciled, so reconcile can tell a first-time result apart from a
+	// repeated one and only emit a PolicyServerReady/PolicyServerUnready
+	// event on an actual transition.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// DeletionBlocked reflects whether this PolicyServer's deletion is
+	// currently blocked on bound policies draining, so deletePoliciesAndRequeue
+	// can tell a first-time result apart from a repeated one and only emit a
+	// PolicyServerDeletionBlocked event on an actual transition.
+	// +optional
+	DeletionBlocked bool `json:"deletionBlocked,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster
+
+// PolicyServer is the Schema for the policyservers API.
+type PolicyServer struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PolicyServerSpec   `json:"spec,omitempty"`
+	Status PolicyServerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PolicyServerList contains a list of PolicyServer.
+type PolicyServerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PolicyServer `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&PolicyServer{}, &PolicyServerList{})
+}
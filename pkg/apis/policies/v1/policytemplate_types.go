@@ -0,0 +1,122 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// PolicyTemplateSpec defines a reusable policy definition that
+// ClusterAdmissionPolicy and AdmissionPolicy objects can instantiate via
+// TemplateRef, instead of repeating Module/Settings/Rules inline. This gives
+// operators a "policy library" pattern: define a hardened template once,
+// instantiate many constrained policies from it.
+type PolicyTemplateSpec struct {
+	// Module is the OCI artifact or URL the policy is fetched from.
+	Module string `json:"module"`
+
+	// SettingsSchema is the OpenAPI/JSON schema that parameter values
+	// provided through a TemplateRef must validate against.
+	// +optional
+	SettingsSchema *runtime.RawExtension `json:"settingsSchema,omitempty"`
+
+	// DefaultSettings is used for any parameter not overridden in TemplateRef.Values.
+	// +optional
+	DefaultSettings *runtime.RawExtension `json:"defaultSettings,omitempty"`
+
+	// Rules defines the default webhook rules for policies instantiated
+	// from this template.
+	// +optional
+	Rules []admissionregistrationv1.RuleWithOperations `json:"rules,omitempty"`
+
+	// FailurePolicy is the default failure policy for policies instantiated
+	// from this template.
+	// +optional
+	// +kubebuilder:validation:Enum=Fail;Ignore
+	FailurePolicy *string `json:"failurePolicy,omitempty"`
+
+	// MatchPolicy is the default match policy for policies instantiated from
+	// this template.
+	// +optional
+	// +kubebuilder:validation:Enum=Equivalent;Exact
+	MatchPolicy *string `json:"matchPolicy,omitempty"`
+
+	// NamespaceSelector is the default namespace selector for policies
+	// instantiated from this template.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+}
+
+// PolicyTemplateStatus reflects the last observed state of a PolicyTemplate.
+type PolicyTemplateStatus struct {
+	// Valid reflects whether SettingsSchema and DefaultSettings were
+	// successfully validated against each other by PolicyTemplateReconciler:
+	// DefaultSettings must unmarshal cleanly and satisfy SettingsSchema, the
+	// same check applied to a TemplateRef's merged values at instantiation
+	// time.
+	// +optional
+	Valid bool `json:"valid,omitempty"`
+
+	// ObservedGeneration is the most recent generation the controller has
+	// validated the SettingsSchema and DefaultSettings for.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster
+
+// PolicyTemplate is a reusable policy definition that ClusterAdmissionPolicy
+// and AdmissionPolicy objects can instantiate through TemplateRef.
+type PolicyTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PolicyTemplateSpec   `json:"spec,omitempty"`
+	Status PolicyTemplateStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PolicyTemplateList contains a list of PolicyTemplate.
+type PolicyTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PolicyTemplate `json:"items"`
+}
+
+// TemplateRef lets a ClusterAdmissionPolicy or AdmissionPolicy instantiate a
+// PolicyTemplate instead of inlining Module/Settings, as an alternative to
+// Spec.Module/Spec.Settings. It is resolved at reconcile time only on a
+// ClusterAdmissionPolicy; on an AdmissionPolicy it is not resolved.
+type TemplateRef struct {
+	// Name of the PolicyTemplate to instantiate.
+	Name string `json:"name"`
+
+	// Values overrides the template's DefaultSettings on a per-parameter
+	// basis. Values are validated against the template's SettingsSchema at
+	// reconcile time.
+	// +optional
+	Values *runtime.RawExtension `json:"values,omitempty"`
+}
+
+func init() {
+	SchemeBuilder.Register(&PolicyTemplate{}, &PolicyTemplateList{})
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import "sigs.k8s.io/controller-runtime/pkg/client"
+
+// RelatedObjectMetadata identifies a RelatedObject inside its Kind/APIVersion.
+type RelatedObjectMetadata struct {
+	// Name of the related object.
+	Name string `json:"name"`
+	// Namespace of the related object. Empty for cluster-scoped objects.
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// RelatedObject references a concrete Kubernetes object that a
+// ClusterAdmissionPolicy, AdmissionPolicy or PolicyServer owns or watches
+// (the policy server ConfigMap, the generated webhook configuration, bound
+// policies), together with its compliance state.
+// It lets a single `kubectl get ... -o yaml` show the full topology and
+// per-resource health without having to walk labels and annotations.
+type RelatedObject struct {
+	// Kind of the related object.
+	Kind string `json:"kind"`
+	// APIVersion of the related object.
+	APIVersion string `json:"apiVersion"`
+	// Metadata identifies the related object.
+	Metadata RelatedObjectMetadata `json:"metadata"`
+	// Compliant is false when the related object is missing, out of sync, or
+	// otherwise not in the state the owning policy/PolicyServer expects.
+	Compliant bool `json:"compliant"`
+	// Reason explains why Compliant is false. Empty when Compliant is true.
+	Reason string `json:"reason,omitempty"`
+}
+
+// NewRelatedObject builds a RelatedObject out of a live client.Object and its
+// GroupVersionKind, as reported by the object's TypeMeta or scheme.
+func NewRelatedObject(obj client.Object, apiVersion, kind string, compliant bool, reason string) RelatedObject {
+	return RelatedObject{
+		Kind:       kind,
+		APIVersion: apiVersion,
+		Metadata: RelatedObjectMetadata{
+			Name:      obj.GetName(),
+			Namespace: obj.GetNamespace(),
+		},
+		Compliant: compliant,
+		Reason:    reason,
+	}
+}
+
+// RelatedObjsOfKind returns the subset of objs whose Kind matches kind,
+// preserving order. Useful to answer "what Deployments/ConfigMaps/
+// WebhookConfigurations are related to this policy?" without re-walking the
+// cluster.
+func RelatedObjsOfKind(objs []RelatedObject, kind string) []RelatedObject {
+	var result []RelatedObject
+	for _, obj := range objs {
+		if obj.Kind == kind {
+			result = append(result, obj)
+		}
+	}
+	return result
+}